@@ -0,0 +1,99 @@
+package test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gruntwork-io/terratest/modules/aws"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFullStackApply brings up the full root module and asserts against the
+// real AWS resources it creates. This is slow and account-scoped, so it only
+// runs when a developer or CI job opts in explicitly.
+func TestFullStackApply(t *testing.T) {
+	if os.Getenv("RUN_APPLY_TESTS") != "true" {
+		t.Skip("skipping apply test; set RUN_APPLY_TESTS=true to run")
+	}
+
+	t.Parallel()
+
+	awsRegion := "eu-west-2"
+
+	terraformOptions := &terraform.Options{
+		TerraformDir: "../",
+		Vars: map[string]interface{}{
+			"environment":          "test",
+			"aws_region":           awsRegion,
+			"vpc_cidr":             "10.99.0.0/16",
+			"db_instance_class":    "db.t3.micro",
+			"db_allocated_storage": 20,
+			"db_name":              "vitracka_test",
+			"db_username":          "vitracka_admin",
+		},
+		NoColor: true,
+	}
+
+	defer terraform.Destroy(t, terraformOptions)
+	terraform.InitAndApply(t, terraformOptions)
+
+	outputs := terraform.OutputMap(t, terraformOptions, "vpc")
+	require.Equal(t, "10.99.0.0/16", outputs["cidr_block"])
+
+	vpcID := terraform.Output(t, terraformOptions, "vpc_id")
+	vpc := aws.GetVpcById(t, vpcID, awsRegion)
+
+	ec2Client := aws.NewEc2Client(t, awsRegion)
+	vpcDetails, err := ec2Client.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: []*string{&vpc.Id}})
+	require.NoError(t, err)
+	require.Len(t, vpcDetails.Vpcs, 1)
+	assert.Equal(t, "10.99.0.0/16", *vpcDetails.Vpcs[0].CidrBlock)
+
+	dbInstanceID := terraform.Output(t, terraformOptions, "db_instance_id")
+	dbInstance, err := aws.GetRdsInstanceDetailsE(t, dbInstanceID, awsRegion)
+	require.NoError(t, err)
+	assert.Equal(t, "available", *dbInstance.DBInstanceStatus)
+	assert.Equal(t, "db.t3.micro", *dbInstance.DBInstanceClass)
+
+	awsSession, err := aws.NewAuthenticatedSession(awsRegion)
+	require.NoError(t, err)
+
+	s3Client := s3.New(awsSession)
+	bucketNames := terraform.OutputList(t, terraformOptions, "storage_bucket_names")
+	for _, bucketName := range bucketNames {
+		bucketName := bucketName
+
+		versioning, err := s3Client.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: &bucketName})
+		require.NoError(t, err)
+		require.NotNil(t, versioning.Status, "expected bucket %s to have versioning enabled", bucketName)
+		assert.Equal(t, s3.BucketVersioningStatusEnabled, *versioning.Status,
+			"expected bucket %s to have versioning enabled", bucketName)
+
+		encryption, err := s3Client.GetBucketEncryption(&s3.GetBucketEncryptionInput{Bucket: &bucketName})
+		require.NoError(t, err)
+		assert.NotEmpty(t, encryption.ServerSideEncryptionConfiguration.Rules,
+			"expected bucket %s to have default encryption configured", bucketName)
+	}
+
+	cloudwatchClient := cloudwatch.New(awsSession)
+	alarmNames := terraform.OutputList(t, terraformOptions, "monitoring_alarm_names")
+	snsTopicArn := terraform.Output(t, terraformOptions, "alert_topic_arn")
+	for _, alarmName := range alarmNames {
+		alarmName := alarmName
+
+		alarms, err := cloudwatchClient.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{AlarmNames: []*string{&alarmName}})
+		require.NoError(t, err)
+		require.Len(t, alarms.MetricAlarms, 1, "expected alarm %s to exist", alarmName)
+
+		actions := make([]string, 0, len(alarms.MetricAlarms[0].AlarmActions))
+		for _, action := range alarms.MetricAlarms[0].AlarmActions {
+			actions = append(actions, *action)
+		}
+		assert.Contains(t, actions, snsTopicArn, "expected alarm %s to notify the alert SNS topic", alarmName)
+	}
+}
@@ -0,0 +1,51 @@
+package test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/edallison777/vitracka/terraform/tests/helpers"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestComplianceScan plans the root module and evaluates the plan against the
+// checks bundled under policies/checks.json, failing on any finding not
+// covered by a committed waiver in policies/waivers.json.
+func TestComplianceScan(t *testing.T) {
+	t.Parallel()
+
+	terraformOptions := &terraform.Options{
+		TerraformDir: "../",
+		Vars: map[string]interface{}{
+			"environment":          "test",
+			"aws_region":           "eu-west-2",
+			"vpc_cidr":             "10.99.0.0/16",
+			"db_instance_class":    "db.t3.micro",
+			"db_allocated_storage": 20,
+			"db_name":              "vitracka_test",
+			"db_username":          "vitracka_admin",
+		},
+		NoColor:      true,
+		PlanFilePath: filepath.Join(t.TempDir(), "plan.out"),
+	}
+
+	terraform.InitAndPlan(t, terraformOptions)
+	planJSON := terraform.RunTerraformCommand(t, terraformOptions, "show", "-json", terraformOptions.PlanFilePath)
+
+	checks, err := helpers.LoadChecks("policies/checks.json")
+	require.NoError(t, err)
+
+	waivers, err := helpers.LoadWaivers("policies/waivers.json")
+	require.NoError(t, err)
+
+	findings, err := helpers.ScanPlan([]byte(planJSON), checks, waivers)
+	require.NoError(t, err)
+
+	var messages []string
+	for _, finding := range findings {
+		messages = append(messages, finding.String())
+	}
+	assert.Empty(t, findings, "compliance scan found violations:\n%s", messages)
+}
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/edallison777/vitracka/terraform/tests/helpers"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPlanCost plans the root module, estimates its monthly cost, and fails
+// if the total exceeds the test environment's budget in budgets.yaml or if
+// any single resource's cost has risen too far above testdata/cost-baseline.json.
+// It requires the infracost CLI, so it skips gracefully when that's not
+// installed rather than failing go test for everyone.
+func TestPlanCost(t *testing.T) {
+	if _, err := exec.LookPath("infracost"); err != nil {
+		t.Skip("skipping cost test; infracost CLI not found on PATH")
+	}
+
+	t.Parallel()
+
+	environment := "test"
+
+	terraformOptions := &terraform.Options{
+		TerraformDir: "../",
+		Vars: map[string]interface{}{
+			"environment":          environment,
+			"aws_region":           "eu-west-2",
+			"vpc_cidr":             "10.99.0.0/16",
+			"db_instance_class":    "db.t3.micro",
+			"db_allocated_storage": 20,
+			"db_name":              "vitracka_test",
+			"db_username":          "vitracka_admin",
+		},
+		NoColor:      true,
+		PlanFilePath: filepath.Join(t.TempDir(), "plan.out"),
+	}
+
+	terraform.InitAndPlan(t, terraformOptions)
+
+	costs, err := helpers.EstimateCosts(terraformOptions.PlanFilePath)
+	require.NoError(t, err)
+
+	budgets, err := helpers.LoadBudgets("budgets.yaml")
+	require.NoError(t, err)
+
+	budget, ok := budgets.Environments[environment]
+	require.True(t, ok, "no budget configured for environment %q", environment)
+
+	total := helpers.TotalMonthlyCost(costs)
+
+	top := helpers.TopNMostExpensive(costs, 5)
+	fmt.Println("Top 5 most expensive resources:")
+	for _, resource := range top {
+		fmt.Printf("  %-40s $%.2f/month\n", resource.ResourceAddress, resource.MonthlyCostUSD)
+	}
+
+	assert.LessOrEqualf(t, total, budget, "estimated monthly cost $%.2f exceeds %s budget of $%.2f", total, environment, budget)
+
+	baseline, err := helpers.LoadCostBaseline("testdata/cost-baseline.json")
+	require.NoError(t, err)
+
+	for _, resource := range costs {
+		baselineCost, tracked := baseline[resource.ResourceAddress]
+		if !tracked || baselineCost == 0 {
+			continue
+		}
+
+		deltaPercent := (resource.MonthlyCostUSD - baselineCost) / baselineCost * 100
+		assert.LessOrEqualf(t, deltaPercent, budgets.MaxResourceDeltaPercent,
+			"resource %s cost increased %.1f%% versus baseline (max allowed %.1f%%)",
+			resource.ResourceAddress, deltaPercent, budgets.MaxResourceDeltaPercent)
+	}
+}
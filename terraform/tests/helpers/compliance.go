@@ -0,0 +1,233 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Check describes a single policy rule loaded from policies/checks.json.
+type Check struct {
+	ID           string `json:"id"`
+	Description  string `json:"description"`
+	ResourceType string `json:"resource_type"`
+	Attribute    string `json:"attribute,omitempty"`
+	Expected     any    `json:"expected,omitempty"`
+	Ports        []int  `json:"ports,omitempty"`
+	Severity     string `json:"severity"`
+}
+
+// Waiver exempts a single resource address from a single check.
+type Waiver struct {
+	CheckID         string `json:"check_id"`
+	ResourceAddress string `json:"resource_address"`
+	Reason          string `json:"reason"`
+}
+
+// Finding is a single check violation found in a Terraform plan.
+type Finding struct {
+	CheckID         string
+	ResourceAddress string
+	Severity        string
+	Message         string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("[%s] %s: %s", f.Severity, f.ResourceAddress, f.Message)
+}
+
+// LoadChecks reads the bundled check definitions from path.
+func LoadChecks(path string) ([]Check, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading checks file %s: %w", path, err)
+	}
+
+	var checks []Check
+	if err := json.Unmarshal(data, &checks); err != nil {
+		return nil, fmt.Errorf("parsing checks file %s: %w", path, err)
+	}
+
+	return checks, nil
+}
+
+// LoadWaivers reads the committed waivers from path.
+func LoadWaivers(path string) ([]Waiver, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading waivers file %s: %w", path, err)
+	}
+
+	var wrapper struct {
+		Waivers []Waiver `json:"waivers"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return nil, fmt.Errorf("parsing waivers file %s: %w", path, err)
+	}
+
+	return wrapper.Waivers, nil
+}
+
+// planResource is the subset of a `terraform show -json` planned_values
+// resource we need to evaluate checks against.
+type planResource struct {
+	Address string         `json:"address"`
+	Type    string         `json:"type"`
+	Values  map[string]any `json:"values"`
+}
+
+// planModule mirrors the recursive root_module/child_modules shape of
+// `terraform show -json`'s planned_values: resources declared directly in
+// the root module sit under "resources", while everything the root module
+// composes from other modules (the security/storage/monitoring/networking
+// modules in this repo) sits one or more "child_modules" levels down.
+type planModule struct {
+	Resources    []planResource `json:"resources"`
+	ChildModules []planModule   `json:"child_modules"`
+}
+
+// collectResources flattens a module's resources and all of its descendant
+// child modules' resources into a single slice.
+func collectResources(module planModule) []planResource {
+	resources := module.Resources
+	for _, child := range module.ChildModules {
+		resources = append(resources, collectResources(child)...)
+	}
+	return resources
+}
+
+// ScanPlan evaluates checks against the planned resources in planJSON and
+// returns findings for anything not covered by a waiver.
+func ScanPlan(planJSON []byte, checks []Check, waivers []Waiver) ([]Finding, error) {
+	var plan struct {
+		PlannedValues struct {
+			RootModule planModule `json:"root_module"`
+		} `json:"planned_values"`
+	}
+	if err := json.Unmarshal(planJSON, &plan); err != nil {
+		return nil, fmt.Errorf("parsing plan JSON: %w", err)
+	}
+
+	waived := make(map[string]bool, len(waivers))
+	for _, w := range waivers {
+		waived[w.CheckID+"|"+w.ResourceAddress] = true
+	}
+
+	resources := collectResources(plan.PlannedValues.RootModule)
+
+	var findings []Finding
+	for _, resource := range resources {
+		for _, check := range checks {
+			if resource.Type != check.ResourceType {
+				continue
+			}
+			if waived[check.ID+"|"+resource.Address] {
+				continue
+			}
+			if finding, violates := evaluateCheck(check, resource, resources); violates {
+				findings = append(findings, finding)
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+func evaluateCheck(check Check, resource planResource, allResources []planResource) (Finding, bool) {
+	switch check.ID {
+	case "RDS_NOT_PUBLIC":
+		actual, ok := resource.Values[check.Attribute].(bool)
+		expected, _ := check.Expected.(bool)
+		if ok && actual != expected {
+			return Finding{
+				CheckID:         check.ID,
+				ResourceAddress: resource.Address,
+				Severity:        check.Severity,
+				Message:         check.Description,
+			}, true
+		}
+	case "S3_SSE_ENABLED":
+		if !hasEncryptionConfig(resource, allResources) {
+			return Finding{
+				CheckID:         check.ID,
+				ResourceAddress: resource.Address,
+				Severity:        check.Severity,
+				Message:         check.Description,
+			}, true
+		}
+	case "SG_NO_OPEN_ADMIN_PORTS":
+		if violatesOpenAdminPorts(resource, check.Ports) {
+			return Finding{
+				CheckID:         check.ID,
+				ResourceAddress: resource.Address,
+				Severity:        check.Severity,
+				Message:         check.Description,
+			}, true
+		}
+	}
+
+	return Finding{}, false
+}
+
+// hasEncryptionConfig reports whether bucket (an aws_s3_bucket resource) has
+// a matching aws_s3_bucket_server_side_encryption_configuration resource,
+// identified by the convention that the encryption config shares the
+// bucket's resource label and module path.
+func hasEncryptionConfig(bucket planResource, allResources []planResource) bool {
+	typePrefix := bucket.Type + "."
+	idx := strings.LastIndex(bucket.Address, typePrefix)
+	if idx == -1 {
+		return false
+	}
+
+	modulePath := bucket.Address[:idx]
+	label := bucket.Address[idx+len(typePrefix):]
+	expectedAddress := modulePath + "aws_s3_bucket_server_side_encryption_configuration." + label
+
+	for _, resource := range allResources {
+		if resource.Type == "aws_s3_bucket_server_side_encryption_configuration" && resource.Address == expectedAddress {
+			return true
+		}
+	}
+
+	return false
+}
+
+func violatesOpenAdminPorts(resource planResource, ports []int) bool {
+	ingress, ok := resource.Values["ingress"].([]any)
+	if !ok {
+		return false
+	}
+
+	for _, raw := range ingress {
+		rule, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		cidrBlocks, _ := rule["cidr_blocks"].([]any)
+		if !containsOpenCIDR(cidrBlocks) {
+			continue
+		}
+
+		fromPort, _ := rule["from_port"].(float64)
+		toPort, _ := rule["to_port"].(float64)
+		for _, port := range ports {
+			if int(fromPort) <= port && port <= int(toPort) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func containsOpenCIDR(cidrBlocks []any) bool {
+	for _, raw := range cidrBlocks {
+		if cidr, ok := raw.(string); ok && cidr == "0.0.0.0/0" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Budgets holds the per-environment monthly spend limits loaded from
+// budgets.yaml, along with the per-resource drift tolerance versus the cost
+// baseline.
+type Budgets struct {
+	Environments            map[string]float64 `yaml:",inline"`
+	MaxResourceDeltaPercent float64            `yaml:"max_resource_delta_percent"`
+}
+
+// LoadBudgets reads the committed budgets.yaml file.
+func LoadBudgets(path string) (Budgets, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Budgets{}, fmt.Errorf("reading budgets file %s: %w", path, err)
+	}
+
+	var budgets Budgets
+	if err := yaml.Unmarshal(data, &budgets); err != nil {
+		return Budgets{}, fmt.Errorf("parsing budgets file %s: %w", path, err)
+	}
+
+	return budgets, nil
+}
+
+// LoadCostBaseline reads the committed per-resource cost baseline.
+func LoadCostBaseline(path string) (map[string]float64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cost baseline %s: %w", path, err)
+	}
+
+	var baseline map[string]float64
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, fmt.Errorf("parsing cost baseline %s: %w", path, err)
+	}
+
+	return baseline, nil
+}
+
+// ResourceCost is the estimated monthly cost of a single planned resource.
+type ResourceCost struct {
+	ResourceAddress string
+	MonthlyCostUSD  float64
+}
+
+// EstimateCosts shells out to infracost against the given plan file and
+// returns a per-resource monthly cost breakdown.
+func EstimateCosts(planFilePath string) ([]ResourceCost, error) {
+	cmd := exec.Command("infracost", "breakdown", "--path", planFilePath, "--format", "json")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("running infracost breakdown: %w", err)
+	}
+
+	var report struct {
+		Projects []struct {
+			Breakdown struct {
+				Resources []struct {
+					Name        string `json:"name"`
+					MonthlyCost string `json:"monthlyCost"`
+				} `json:"resources"`
+			} `json:"breakdown"`
+		} `json:"projects"`
+	}
+	if err := json.Unmarshal(output, &report); err != nil {
+		return nil, fmt.Errorf("parsing infracost output: %w", err)
+	}
+
+	var costs []ResourceCost
+	for _, project := range report.Projects {
+		for _, resource := range project.Breakdown.Resources {
+			var monthlyCost float64
+			fmt.Sscanf(resource.MonthlyCost, "%f", &monthlyCost)
+			costs = append(costs, ResourceCost{
+				ResourceAddress: resource.Name,
+				MonthlyCostUSD:  monthlyCost,
+			})
+		}
+	}
+
+	return costs, nil
+}
+
+// TotalMonthlyCost sums the monthly cost across all resources.
+func TotalMonthlyCost(costs []ResourceCost) float64 {
+	var total float64
+	for _, cost := range costs {
+		total += cost.MonthlyCostUSD
+	}
+	return total
+}
+
+// TopNMostExpensive returns the n most expensive resources, most expensive
+// first, for printing in a PR-readable summary.
+func TopNMostExpensive(costs []ResourceCost, n int) []ResourceCost {
+	sorted := make([]ResourceCost, len(costs))
+	copy(sorted, costs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].MonthlyCostUSD > sorted[j].MonthlyCostUSD
+	})
+
+	if n > len(sorted) {
+		n = len(sorted)
+	}
+	return sorted[:n]
+}
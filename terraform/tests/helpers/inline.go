@@ -0,0 +1,42 @@
+// Package helpers provides shared test utilities for the vitracka Terraform
+// test suite.
+package helpers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/files"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+// InlineModuleOptions copies moduleDir into a temp directory and writes
+// sourceTemplate (a fmt.Sprintf template with a single %q placeholder for the
+// copied module's path) into that directory as main.tf, returning
+// terraform.Options pointing at it. This lets negative/policy tests
+// instantiate a module with deliberately bad inputs without checking in a
+// one-off fixture directory for every failure case, and without depending on
+// a relative path from wherever t.TempDir() happens to live.
+func InlineModuleOptions(t *testing.T, moduleDir string, sourceTemplate string, vars map[string]interface{}) *terraform.Options {
+	t.Helper()
+
+	absModuleDir, err := filepath.Abs(moduleDir)
+	require.NoError(t, err)
+
+	tmpDir := t.TempDir()
+	moduleCopyDir := filepath.Join(tmpDir, "module")
+	require.NoError(t, files.CopyFolderContents(absModuleDir, moduleCopyDir))
+
+	mainTf := fmt.Sprintf(sourceTemplate, moduleCopyDir)
+	mainTfPath := filepath.Join(tmpDir, "main.tf")
+	require.NoError(t, os.WriteFile(mainTfPath, []byte(mainTf), 0644))
+
+	return &terraform.Options{
+		TerraformDir: tmpDir,
+		Vars:         vars,
+		NoColor:      true,
+	}
+}
@@ -0,0 +1,36 @@
+package helpers
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MatrixCell is a single region/AZ/instance-class combination exercised by
+// TestMatrix.
+type MatrixCell struct {
+	Name                  string   `yaml:"name"`
+	Region                string   `yaml:"region"`
+	AvailabilityZones     []string `yaml:"availability_zones"`
+	DBInstanceClass       string   `yaml:"db_instance_class"`
+	TerraformBinary       string   `yaml:"terraform_binary"`
+	ExpectedResourceCount int      `yaml:"expected_resource_count"`
+}
+
+// LoadMatrixCells reads the region/AZ/instance-class table from path.
+func LoadMatrixCells(path string) ([]MatrixCell, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading matrix file %s: %w", path, err)
+	}
+
+	var wrapper struct {
+		Cells []MatrixCell `yaml:"cells"`
+	}
+	if err := yaml.Unmarshal(data, &wrapper); err != nil {
+		return nil, fmt.Errorf("parsing matrix file %s: %w", path, err)
+	}
+
+	return wrapper.Cells, nil
+}
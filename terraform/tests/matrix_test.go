@@ -0,0 +1,53 @@
+package test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/edallison777/vitracka/terraform/tests/helpers"
+	"github.com/gruntwork-io/terratest/modules/logger"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMatrix plans the root module against every region/AZ/instance-class
+// combination in testdata/matrix.yaml, so a provider-version or
+// region-specific regression is caught against the exact cell that broke
+// rather than only the hard-coded eu-west-2 path the other tests exercise.
+func TestMatrix(t *testing.T) {
+	t.Parallel()
+
+	cells, err := helpers.LoadMatrixCells("testdata/matrix.yaml")
+	require.NoError(t, err)
+
+	for _, cell := range cells {
+		cell := cell
+
+		t.Run(cell.Name, func(t *testing.T) {
+			t.Parallel()
+
+			terraformOptions := &terraform.Options{
+				TerraformDir: "../",
+				Vars: map[string]interface{}{
+					"environment":          "test",
+					"aws_region":           cell.Region,
+					"vpc_cidr":             "10.99.0.0/16",
+					"availability_zones":   cell.AvailabilityZones,
+					"db_instance_class":    cell.DBInstanceClass,
+					"db_allocated_storage": 20,
+					"db_name":              "vitracka_test",
+					"db_username":          "vitracka_admin",
+				},
+				NoColor:         true,
+				Logger:          logger.Discard,
+				TerraformBinary: cell.TerraformBinary,
+				PlanFilePath:    filepath.Join(t.TempDir(), "plan.out"),
+			}
+
+			plan := terraform.InitAndPlanAndShowWithStruct(t, terraformOptions)
+			assert.Len(t, plan.ResourcePlannedValuesMap, cell.ExpectedResourceCount,
+				"cell %s produced an unexpected resource count", cell.Name)
+		})
+	}
+}
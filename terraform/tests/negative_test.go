@@ -0,0 +1,72 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/edallison777/vitracka/terraform/tests/helpers"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSecurityModule_RejectsOpenIngress asserts that the security module
+// refuses to plan a security group rule that opens ingress to the world.
+func TestSecurityModule_RejectsOpenIngress(t *testing.T) {
+	t.Parallel()
+
+	sourceTemplate := `
+module "security" {
+  source      = %q
+  name_prefix = "test-vitracka"
+  vpc_id      = "vpc-12345678"
+  environment = "test"
+
+  ingress_rules = [
+    {
+      description = "open to the world"
+      from_port   = 22
+      to_port     = 22
+      protocol    = "tcp"
+      cidr_blocks = ["0.0.0.0/0"]
+    },
+  ]
+
+  tags = {
+    Environment = "test"
+    Project     = "vitracka"
+  }
+}
+`
+
+	terraformOptions := helpers.InlineModuleOptions(t, "../modules/security", sourceTemplate, nil)
+
+	_, err := terraform.InitAndPlanE(t, terraformOptions)
+	assert.ErrorContains(t, err, "ingress rules must not allow 0.0.0.0/0 on port 22 or 3389")
+}
+
+// TestNetworkingModule_RejectsOverlappingCIDRs asserts that the networking
+// module refuses to plan subnets whose CIDR ranges overlap.
+func TestNetworkingModule_RejectsOverlappingCIDRs(t *testing.T) {
+	t.Parallel()
+
+	sourceTemplate := `
+module "networking" {
+  source              = %q
+  name_prefix         = "test-vitracka"
+  vpc_cidr            = "10.99.0.0/16"
+  availability_zones  = ["eu-west-2a", "eu-west-2b"]
+  environment         = "test"
+
+  private_subnet_cidrs = ["10.99.0.0/24", "10.99.0.0/24"]
+
+  tags = {
+    Environment = "test"
+    Project     = "vitracka"
+  }
+}
+`
+
+	terraformOptions := helpers.InlineModuleOptions(t, "../modules/networking", sourceTemplate, nil)
+
+	_, err := terraform.InitAndPlanE(t, terraformOptions)
+	assert.ErrorContains(t, err, "subnet CIDR blocks must not overlap")
+}
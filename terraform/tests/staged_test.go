@@ -0,0 +1,150 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/gruntwork-io/terratest/modules/test-structure"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNetworkingModule exercises the networking module through discrete
+// setup/plan/apply/verify/teardown stages. Each stage can be skipped with
+// SKIP_<stage>=true, e.g. `SKIP_teardown=true go test -run TestNetworkingModule`
+// followed by `SKIP_setup=true SKIP_plan=true SKIP_apply=true go test -run TestNetworkingModule`
+// to re-run verify against the persisted state.
+func TestNetworkingModule(t *testing.T) {
+	t.Parallel()
+
+	workingDir := test_structure.CopyTerraformFolderToTemp(t, "../modules/networking", ".")
+
+	defer test_structure.RunTestStage(t, "teardown", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		terraform.Destroy(t, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "setup", func() {
+		terraformOptions := &terraform.Options{
+			TerraformDir: workingDir,
+			Vars: map[string]interface{}{
+				"name_prefix":        "test-vitracka",
+				"vpc_cidr":           "10.99.0.0/16",
+				"availability_zones": []string{"eu-west-2a", "eu-west-2b", "eu-west-2c"},
+				"environment":        "test",
+				"tags": map[string]string{
+					"Environment": "test",
+					"Project":     "vitracka",
+				},
+			},
+			NoColor: true,
+		}
+		test_structure.SaveTerraformOptions(t, workingDir, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "plan", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		terraform.InitAndPlan(t, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "apply", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		terraform.Apply(t, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "verify", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		vpcID := terraform.Output(t, terraformOptions, "vpc_id")
+		assert.NotEmpty(t, vpcID)
+	})
+}
+
+// TestStorageModule exercises the storage module through the same staged
+// lifecycle as TestNetworkingModule.
+func TestStorageModule(t *testing.T) {
+	t.Parallel()
+
+	workingDir := test_structure.CopyTerraformFolderToTemp(t, "../modules/storage", ".")
+
+	defer test_structure.RunTestStage(t, "teardown", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		terraform.Destroy(t, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "setup", func() {
+		terraformOptions := &terraform.Options{
+			TerraformDir: workingDir,
+			Vars: map[string]interface{}{
+				"name_prefix": "test-vitracka",
+				"environment": "test",
+				"tags": map[string]string{
+					"Environment": "test",
+					"Project":     "vitracka",
+				},
+			},
+			NoColor: true,
+		}
+		test_structure.SaveTerraformOptions(t, workingDir, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "plan", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		terraform.InitAndPlan(t, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "apply", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		terraform.Apply(t, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "verify", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		bucketNames := terraform.OutputList(t, terraformOptions, "bucket_names")
+		assert.NotEmpty(t, bucketNames)
+	})
+}
+
+// TestMonitoringModule exercises the monitoring module through the same
+// staged lifecycle as TestNetworkingModule.
+func TestMonitoringModule(t *testing.T) {
+	t.Parallel()
+
+	workingDir := test_structure.CopyTerraformFolderToTemp(t, "../modules/monitoring", ".")
+
+	defer test_structure.RunTestStage(t, "teardown", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		terraform.Destroy(t, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "setup", func() {
+		terraformOptions := &terraform.Options{
+			TerraformDir: workingDir,
+			Vars: map[string]interface{}{
+				"name_prefix": "test-vitracka",
+				"environment": "test",
+				"alert_email": "test@vitracka.com",
+				"tags": map[string]string{
+					"Environment": "test",
+					"Project":     "vitracka",
+				},
+			},
+			NoColor: true,
+		}
+		test_structure.SaveTerraformOptions(t, workingDir, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "plan", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		terraform.InitAndPlan(t, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "apply", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		terraform.Apply(t, terraformOptions)
+	})
+
+	test_structure.RunTestStage(t, "verify", func() {
+		terraformOptions := test_structure.LoadTerraformOptions(t, workingDir)
+		alarmNames := terraform.OutputList(t, terraformOptions, "alarm_names")
+		assert.NotEmpty(t, alarmNames)
+	})
+}
@@ -61,28 +61,6 @@ func TestTerraformFormat(t *testing.T) {
 	terraform.Format(t, terraformOptions)
 }
 
-func TestNetworkingModule(t *testing.T) {
-	t.Parallel()
-
-	terraformOptions := &terraform.Options{
-		TerraformDir: "../modules/networking",
-		Vars: map[string]interface{}{
-			"name_prefix":         "test-vitracka",
-			"vpc_cidr":           "10.99.0.0/16",
-			"availability_zones": []string{"eu-west-2a", "eu-west-2b", "eu-west-2c"},
-			"environment":        "test",
-			"tags": map[string]string{
-				"Environment": "test",
-				"Project":     "vitracka",
-			},
-		},
-		NoColor: true,
-	}
-
-	defer terraform.Destroy(t, terraformOptions)
-	terraform.InitAndPlan(t, terraformOptions)
-}
-
 func TestSecurityModule(t *testing.T) {
 	t.Parallel()
 
@@ -104,43 +82,3 @@ func TestSecurityModule(t *testing.T) {
 	terraform.Validate(t, terraformOptions)
 }
 
-func TestStorageModule(t *testing.T) {
-	t.Parallel()
-
-	terraformOptions := &terraform.Options{
-		TerraformDir: "../modules/storage",
-		Vars: map[string]interface{}{
-			"name_prefix": "test-vitracka",
-			"environment": "test",
-			"tags": map[string]string{
-				"Environment": "test",
-				"Project":     "vitracka",
-			},
-		},
-		NoColor: true,
-	}
-
-	defer terraform.Destroy(t, terraformOptions)
-	terraform.InitAndPlan(t, terraformOptions)
-}
-
-func TestMonitoringModule(t *testing.T) {
-	t.Parallel()
-
-	terraformOptions := &terraform.Options{
-		TerraformDir: "../modules/monitoring",
-		Vars: map[string]interface{}{
-			"name_prefix": "test-vitracka",
-			"environment": "test",
-			"alert_email": "test@vitracka.com",
-			"tags": map[string]string{
-				"Environment": "test",
-				"Project":     "vitracka",
-			},
-		},
-		NoColor: true,
-	}
-
-	defer terraform.Destroy(t, terraformOptions)
-	terraform.InitAndPlan(t, terraformOptions)
-}
\ No newline at end of file